@@ -0,0 +1,121 @@
+package lcd
+
+import "time"
+
+// Sprite is a custom character animated across the display by a Scene.
+type Sprite struct {
+	Location byte // CGRAM location (0-7) the glyph was uploaded to
+	Row      int
+	Col      int
+
+	direction int
+	min       int
+	max       int
+}
+
+// NewSprite uploads glyph into CGRAM at location and places it at
+// (row, col). The LCD passed in must have a worker started (see
+// LCD.StartWorker) before the sprite is driven through a Scene.
+func NewSprite(lcd *LCD, location byte, glyph [8]byte, row, col int) (*Sprite, error) {
+	if err := lcd.UploadCustomChar(location, glyph); err != nil {
+		return nil, err
+	}
+	return &Sprite{Location: location, Row: row, Col: col}, nil
+}
+
+// Bounce configures the sprite to walk back and forth between columns min
+// and max on its current row, starting by moving right, and returns the
+// sprite for chaining.
+func (s *Sprite) Bounce(min, max int) *Sprite {
+	s.min = min
+	s.max = max
+	s.direction = 1
+	return s
+}
+
+// step advances the sprite by one cell and returns the cell it vacated, so
+// the caller can blank it.
+func (s *Sprite) step() (oldRow, oldCol int) {
+	oldRow, oldCol = s.Row, s.Col
+	if s.direction == 0 {
+		return
+	}
+
+	switch {
+	case s.Col <= s.min:
+		s.direction = 1
+	case s.Col >= s.max:
+		s.direction = -1
+	}
+	s.Col += s.direction
+	return
+}
+
+// Scene drives a set of sprites on top of an async-enabled LCD: each Step
+// blanks every sprite's previous cell and redraws it at its new position via
+// PrintRAWAsync, so the worker's framebuffer diffing coalesces the writes
+// into the minimum needed to animate.
+type Scene struct {
+	lcd     *LCD
+	sprites []*Sprite
+}
+
+// NewScene creates a Scene that animates sprites on lcd, which must already
+// have a worker running (see LCD.StartWorker).
+func NewScene(lcd *LCD) *Scene {
+	return &Scene{lcd: lcd}
+}
+
+// AddSprite adds sprite to the scene and returns the scene for chaining, so
+// callers can write scene.AddSprite(cthulhu).
+func (sc *Scene) AddSprite(s *Sprite) *Scene {
+	sc.sprites = append(sc.sprites, s)
+	return sc
+}
+
+// Step advances every sprite in the scene by one frame.
+func (sc *Scene) Step() error {
+	for _, s := range sc.sprites {
+		oldRow, oldCol := s.step()
+		if err := sc.lcd.PrintRAWAsync(' ', oldRow, oldCol); err != nil {
+			return err
+		}
+		if err := sc.lcd.PrintRAWAsync(s.Location, s.Row, s.Col); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ticker calls a step function at a fixed frame rate until stopped, driving
+// a Scene (or any other per-frame callback).
+type Ticker struct {
+	ticker *time.Ticker
+	done   chan struct{}
+}
+
+// NewTicker starts a Ticker that calls step once per frame at fps frames
+// per second, until Stop is called.
+func NewTicker(fps int, step func() error) *Ticker {
+	t := &Ticker{
+		ticker: time.NewTicker(time.Second / time.Duration(fps)),
+		done:   make(chan struct{}),
+	}
+	go func() {
+		for {
+			select {
+			case <-t.ticker.C:
+				_ = step()
+			case <-t.done:
+				return
+			}
+		}
+	}()
+	return t
+}
+
+// Stop halts the ticker.
+func (t *Ticker) Stop() {
+	t.ticker.Stop()
+	close(t.done)
+}
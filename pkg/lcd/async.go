@@ -0,0 +1,187 @@
+package lcd
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// errWorkerNotStarted reports a *Async method called before StartWorker.
+func errWorkerNotStarted(method string) error {
+	return fmt.Errorf("lcd: %s called before StartWorker", method)
+}
+
+// cell is one shadow DDRAM entry: the last raw code point the worker
+// actually wrote to (row, col), so it can skip writes that wouldn't change
+// anything.
+type cell struct {
+	known bool
+	value byte
+}
+
+// frameCmd is a single queued write: a raw code point destined for
+// (row, col).
+type frameCmd struct {
+	row, col int
+	raw      byte
+}
+
+// worker owns lcd's bus from its own goroutine and diffs writes against a
+// shadow copy of DDRAM, so PrintAsync/ClearAsync callers never block on I2C
+// or GPIO I/O, unchanged cells never get re-sent, and runs of changed cells
+// that are DDRAM-adjacent to the last thing actually written are sent as a
+// single burst (relying on the controller's own address auto-increment)
+// instead of a SetCursor per cell.
+type worker struct {
+	lcd    *LCD
+	queue  chan frameCmd
+	done   chan struct{}
+	errs   chan error
+	shadow [][]cell // shadow[row][col]
+
+	lastValid        bool
+	lastRow, lastCol int // DDRAM address the controller's auto-increment is sitting just past
+}
+
+// StartWorker spawns a goroutine that owns lcd's bus and applies queued
+// writes in the background, skipping any cell whose value hasn't changed
+// since the last write. queueSize bounds how many pending writes
+// PrintAsync/ClearAsync can hold before they start blocking the caller.
+// Once a worker is running, Print/Write/Clear and the other synchronous
+// methods must not be called concurrently, since they'd race the worker for
+// the bus.
+func (lcd *LCD) StartWorker(queueSize int) {
+	w := &worker{
+		lcd:   lcd,
+		queue: make(chan frameCmd, queueSize),
+		done:  make(chan struct{}),
+		errs:  make(chan error, queueSize),
+	}
+	w.shadow = make([][]cell, lcd.rows)
+	for r := range w.shadow {
+		w.shadow[r] = make([]cell, lcd.cols)
+	}
+
+	lcd.worker = w
+	go w.run()
+}
+
+// StopWorker stops the background worker started by StartWorker, waiting
+// for queued writes to drain first. It is a no-op if no worker is running.
+func (lcd *LCD) StopWorker() {
+	if lcd.worker == nil {
+		return
+	}
+	close(lcd.worker.queue)
+	<-lcd.worker.done
+	lcd.worker = nil
+}
+
+// Errors returns the channel background write failures are reported on, or
+// nil if no worker is running (StartWorker must be called first); reading
+// from a nil channel blocks forever, same as there being nothing to report.
+// Callers that care about I/O errors from the worker should drain it;
+// failures are dropped if the channel is full.
+func (lcd *LCD) Errors() <-chan error {
+	if lcd.worker == nil {
+		return nil
+	}
+	return lcd.worker.errs
+}
+
+func (w *worker) run() {
+	defer close(w.done)
+	for cmd := range w.queue {
+		w.apply(cmd)
+	}
+}
+
+func (w *worker) apply(cmd frameCmd) {
+	cur := &w.shadow[cmd.row][cmd.col]
+	if cur.known && cur.value == cmd.raw {
+		return
+	}
+
+	// Burst: if the last byte actually written left the controller's DDRAM
+	// address pointer sitting right before this cell, writing data alone
+	// lets the controller's own auto-increment move it, skipping the
+	// SetCursor command entirely.
+	adjacent := w.lastValid && cmd.row == w.lastRow && cmd.col == w.lastCol+1
+	if !adjacent {
+		if err := w.lcd.SetCursor(cmd.row, cmd.col); err != nil {
+			w.reportErr(err)
+			w.lastValid = false
+			return
+		}
+	}
+	if err := w.lcd.WriteRAW(cmd.raw); err != nil {
+		w.reportErr(err)
+		w.lastValid = false
+		return
+	}
+
+	cur.known = true
+	cur.value = cmd.raw
+	w.lastRow, w.lastCol, w.lastValid = cmd.row, cmd.col, true
+}
+
+func (w *worker) reportErr(err error) {
+	select {
+	case w.errs <- err:
+	default:
+	}
+}
+
+// PrintAsync enqueues text to be written starting at (row, col), translated
+// like Write, without blocking on the bus. It returns an error, without
+// enqueuing anything, if any cell the text would occupy falls outside the
+// display (e.g. text runs past the last column). StartWorker must have
+// been called first.
+func (lcd *LCD) PrintAsync(text string, row, col int) error {
+	if lcd.worker == nil {
+		return errWorkerNotStarted("PrintAsync")
+	}
+	if n := utf8.RuneCountInString(text); n > 0 {
+		if err := lcd.validateCell(row, col); err != nil {
+			return err
+		}
+		if err := lcd.validateCell(row, col+n-1); err != nil {
+			return err
+		}
+	}
+
+	c := col
+	for _, r := range text {
+		lcd.worker.queue <- frameCmd{row: row, col: c, raw: lcd.translate(r)}
+		c++
+	}
+	return nil
+}
+
+// PrintRAWAsync enqueues a single raw controller code point at (row, col)
+// without blocking on the bus. It returns an error, without enqueuing
+// anything, if (row, col) is outside the display. StartWorker must have
+// been called first.
+func (lcd *LCD) PrintRAWAsync(raw byte, row, col int) error {
+	if lcd.worker == nil {
+		return errWorkerNotStarted("PrintRAWAsync")
+	}
+	if err := lcd.validateCell(row, col); err != nil {
+		return err
+	}
+	lcd.worker.queue <- frameCmd{row: row, col: col, raw: raw}
+	return nil
+}
+
+// ClearAsync enqueues blanking the whole display without blocking on the
+// bus. StartWorker must have been called first.
+func (lcd *LCD) ClearAsync() error {
+	if lcd.worker == nil {
+		return errWorkerNotStarted("ClearAsync")
+	}
+	for row := 0; row < lcd.rows; row++ {
+		for col := 0; col < lcd.cols; col++ {
+			lcd.worker.queue <- frameCmd{row: row, col: col, raw: ' '}
+		}
+	}
+	return nil
+}
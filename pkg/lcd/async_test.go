@@ -0,0 +1,102 @@
+package lcd
+
+import "testing"
+
+// TestAsyncBoundsChecking is a regression test: PrintAsync and PrintRAWAsync
+// previously enqueued out-of-range writes straight into the
+// worker, which then panicked on the shadow buffer instead of the caller
+// getting an error back.
+func TestAsyncBoundsChecking(t *testing.T) {
+	lcd, _ := newTestLCD(t, 16, 2)
+	lcd.StartWorker(8)
+	defer lcd.StopWorker()
+
+	if err := lcd.PrintAsync("0123456789ABCDEFG", 0, 0); err == nil {
+		t.Error("PrintAsync with text running past the last column: got nil error, want error")
+	}
+	if err := lcd.PrintRAWAsync(' ', 0, 16); err == nil {
+		t.Error("PrintRAWAsync with out-of-range col: got nil error, want error")
+	}
+	if err := lcd.PrintRAWAsync(' ', 2, 0); err == nil {
+		t.Error("PrintRAWAsync with out-of-range row: got nil error, want error")
+	}
+
+	if err := lcd.PrintAsync("hello", 0, 0); err != nil {
+		t.Errorf("PrintAsync within bounds: got error %v, want nil", err)
+	}
+}
+
+func TestAsyncBeforeStartWorker(t *testing.T) {
+	lcd, _ := newTestLCD(t, 16, 2)
+
+	if err := lcd.PrintAsync("hi", 0, 0); err == nil {
+		t.Error("PrintAsync before StartWorker: got nil error, want error")
+	}
+	if err := lcd.PrintRAWAsync(' ', 0, 0); err == nil {
+		t.Error("PrintRAWAsync before StartWorker: got nil error, want error")
+	}
+	if err := lcd.ClearAsync(); err == nil {
+		t.Error("ClearAsync before StartWorker: got nil error, want error")
+	}
+	if lcd.Errors() != nil {
+		t.Error("Errors() before StartWorker: got non-nil channel, want nil")
+	}
+}
+
+// TestAsyncSkipsUnchangedCells checks that writing the same value to a cell
+// twice only sends it to the bus once.
+func TestAsyncSkipsUnchangedCells(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	lcd.StartWorker(8)
+
+	if err := lcd.PrintRAWAsync('A', 0, 0); err != nil {
+		t.Fatalf("PrintRAWAsync: %v", err)
+	}
+	if err := lcd.PrintRAWAsync('A', 0, 0); err != nil {
+		t.Fatalf("PrintRAWAsync: %v", err)
+	}
+	lcd.StopWorker()
+
+	if len(fb.data) != 1 || fb.data[0] != 'A' {
+		t.Errorf("data written = %v, want a single 'A'", fb.data)
+	}
+}
+
+// TestAsyncBurstsAdjacentWrites is a regression test: adjacent DDRAM writes
+// should rely on the controller's own address auto-increment instead of
+// issuing a redundant SetCursor per cell.
+func TestAsyncBurstsAdjacentWrites(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	lcd.StartWorker(8)
+
+	if err := lcd.PrintAsync("AB", 0, 0); err != nil {
+		t.Fatalf("PrintAsync: %v", err)
+	}
+	lcd.StopWorker()
+
+	if len(fb.commands) != 1 {
+		t.Errorf("commands = %v, want exactly one SetCursor for the whole burst", fb.commands)
+	}
+	if string(fb.data) != "AB" {
+		t.Errorf("data written = %v, want \"AB\"", fb.data)
+	}
+}
+
+// TestAsyncNonAdjacentWritesEachGetACursorMove checks that writes to
+// non-adjacent cells don't get coalesced into a single burst.
+func TestAsyncNonAdjacentWritesEachGetACursorMove(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	lcd.StartWorker(8)
+
+	if err := lcd.PrintRAWAsync('A', 0, 0); err != nil {
+		t.Fatalf("PrintRAWAsync: %v", err)
+	}
+	if err := lcd.PrintRAWAsync('B', 1, 0); err != nil {
+		t.Fatalf("PrintRAWAsync: %v", err)
+	}
+	lcd.StopWorker()
+
+	if len(fb.commands) != 2 {
+		t.Errorf("commands = %v, want one SetCursor per non-adjacent write", fb.commands)
+	}
+}
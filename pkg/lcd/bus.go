@@ -0,0 +1,97 @@
+package lcd
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/io/i2c"
+)
+
+const (
+	// pcfReadMode = 0x2 // useless in most cases, due to PCF8574-like circuits
+	pcfWriteMode    = 0x0
+	pcfEnableBit    = 0x4
+	pcfBacklightOn  = 0x08
+	pcfBacklightOff = 0x00
+)
+
+// Bus abstracts the physical transport between LCD and an HD44780-compatible
+// controller, so LCD itself never talks to I2C or GPIO directly.
+//
+// WriteNibble sends the lower 4 bits of data to the controller, with rs
+// selecting the instruction register (lcdRegisterCommand) or the data
+// register (lcdRegisterData); LCD always calls it twice per byte, high
+// nibble then low nibble, since the controller is only ever driven in 4-bit
+// transfer mode.
+type Bus interface {
+	WriteNibble(data, rs byte) error
+	SetBacklight(on bool) error
+	Close() error
+}
+
+// PCF8574Bus drives an HD44780 controller through a PCF8574 I2C expander,
+// wired as described in the comment at the top of lcd.go: P0-P2 carry RS/RW/E, P3
+// drives the backlight transistor, and P4-P7 carry the nibble.
+type PCF8574Bus struct {
+	dev       *i2c.Device
+	backlight byte
+}
+
+// NewPCF8574Bus opens the I2C device at address on bus and returns a Bus
+// backed by it. bus is typically DefaultDevice and address DefaultAddress
+// for common PCF8574-based modules.
+func NewPCF8574Bus(bus string, address int) (*PCF8574Bus, error) {
+	dev, err := i2c.Open(&i2c.Devfs{Dev: bus}, address)
+	if err != nil {
+		return nil, err
+	}
+	return &PCF8574Bus{dev: dev}, nil
+}
+
+// WriteNibble implements Bus.
+func (b *PCF8574Bus) WriteNibble(data, rs byte) error {
+	// Prepare I2C data.
+	// Start filling data with technical bits (P0-P3).
+	packed := rs | pcfWriteMode | pcfEnableBit | b.backlight
+
+	// Set data bits (P4-P7).
+	packed |= data << 4
+
+	// Send to I2C device.
+	if err := b.write(packed); err != nil {
+		return err
+	}
+
+	// Toggle enable bit to latch data.
+	packed &= ^(byte(pcfEnableBit))
+	if err := b.write(packed); err != nil {
+		return err
+	}
+
+	// Small delay for timing.
+	time.Sleep(50 * time.Microsecond)
+	return nil
+}
+
+// SetBacklight implements Bus.
+func (b *PCF8574Bus) SetBacklight(on bool) error {
+	if on {
+		b.backlight = pcfBacklightOn
+	} else {
+		b.backlight = pcfBacklightOff
+	}
+	return b.write(b.backlight)
+}
+
+// Close implements Bus.
+func (b *PCF8574Bus) Close() error {
+	return b.dev.Close()
+}
+
+// write writes a single byte to the I2C bus.
+func (b *PCF8574Bus) write(data byte) error {
+	if err := b.dev.Write([]byte{data}); err != nil {
+		return fmt.Errorf("I2C write error: %v", err)
+	}
+	return nil
+}
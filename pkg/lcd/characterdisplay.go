@@ -0,0 +1,117 @@
+package lcd
+
+import "fmt"
+
+// CharacterDisplay wraps an LCD with cursor tracking, so callers can stream
+// text without manually calling SetCursor for every write: it auto-wraps at
+// the column boundary and scrolls the display up one row once the last row
+// is used. Since DDRAM can't be read back from the controller, scrolling is
+// done by keeping a shadow copy of what's currently shown and redrawing the
+// whole display from it.
+type CharacterDisplay struct {
+	lcd      *LCD
+	row, col int
+	buf      [][]byte // buf[row][col], shadow of what's currently on screen
+}
+
+// NewCharacterDisplay wraps lcd, tracking the cursor starting at (0, 0).
+// Runes the display can't show are translated per lcd's CharsetROM and
+// registered glyphs (see LCD.SetFallback, LCD.RegisterGlyphs).
+func NewCharacterDisplay(lcd *LCD) *CharacterDisplay {
+	cd := &CharacterDisplay{lcd: lcd}
+	cd.buf = make([][]byte, lcd.rows)
+	for r := range cd.buf {
+		cd.buf[r] = blankRow(lcd.cols)
+	}
+	return cd
+}
+
+// blankRow returns a row of n space characters.
+func blankRow(n int) []byte {
+	row := make([]byte, n)
+	for i := range row {
+		row[i] = ' '
+	}
+	return row
+}
+
+// Message prints text starting at the current cursor position. '\n' moves
+// to column 0 of the next row (scrolling the display up if that was the
+// last row); any other character advances the cursor, wrapping at the end
+// of a row.
+func (cd *CharacterDisplay) Message(text string) error {
+	for _, r := range text {
+		if err := cd.WriteRune(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Printf formats according to format and args and writes the result via
+// Message.
+func (cd *CharacterDisplay) Printf(format string, args ...any) error {
+	return cd.Message(fmt.Sprintf(format, args...))
+}
+
+// WriteRune writes a single rune at the current cursor position, advancing
+// and wrapping/scrolling as needed. '\n' moves to column 0 of the next row
+// without printing anything.
+func (cd *CharacterDisplay) WriteRune(r rune) error {
+	if r == '\n' {
+		return cd.newline()
+	}
+
+	raw := cd.lcd.translate(r)
+	if err := cd.lcd.SetCursor(cd.row, cd.col); err != nil {
+		return err
+	}
+	if err := cd.lcd.WriteRAW(raw); err != nil {
+		return err
+	}
+	cd.buf[cd.row][cd.col] = raw
+
+	cd.col++
+	if cd.col >= cd.lcd.cols {
+		return cd.newline()
+	}
+	return nil
+}
+
+// newline moves to column 0 of the next row, scrolling the display up one
+// row once the last row has been used.
+func (cd *CharacterDisplay) newline() error {
+	cd.col = 0
+	cd.row++
+	if cd.row < cd.lcd.rows {
+		return nil
+	}
+	cd.row = cd.lcd.rows - 1
+	return cd.scroll()
+}
+
+// scroll shifts every buffered row's content up by one row, blanks the new
+// last row, and redraws the whole display from the shadow buffer. A full
+// redraw is required because the controller offers no way to read DDRAM
+// back and shift it in place.
+func (cd *CharacterDisplay) scroll() error {
+	for r := 0; r < len(cd.buf)-1; r++ {
+		copy(cd.buf[r], cd.buf[r+1])
+	}
+	last := cd.buf[len(cd.buf)-1]
+	for i := range last {
+		last[i] = ' '
+	}
+
+	for row, line := range cd.buf {
+		if err := cd.lcd.SetCursor(row, 0); err != nil {
+			return err
+		}
+		for _, raw := range line {
+			if err := cd.lcd.WriteRAW(raw); err != nil {
+				return err
+			}
+		}
+	}
+	return cd.lcd.SetCursor(cd.row, cd.col)
+}
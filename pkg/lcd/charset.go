@@ -0,0 +1,51 @@
+package lcd
+
+// CharsetROM selects which HD44780 character-set ROM is installed in the
+// controller, which determines how code points above the ASCII range map to
+// glyphs. Most PCF8574 modules ship with ROMA00; check your datasheet.
+type CharsetROM int
+
+const (
+	// ROMA00 is the Japanese character-set ROM, with Katakana in the upper
+	// code range.
+	ROMA00 CharsetROM = iota
+	// ROMA02 is the European character-set ROM, with Western European
+	// accented characters, Greek letters and Cyrillic in the upper range.
+	ROMA02
+)
+
+// charsetTables maps runes outside the ASCII range to their controller code
+// point for a given ROM. ASCII 0x20-0x7D maps onto the controller's own code
+// points unchanged and is handled directly in translate, so only the
+// non-ASCII subset each ROM natively supports needs an entry here.
+var charsetTables = map[CharsetROM]map[rune]byte{
+	ROMA00: {
+		'→': 0x7E,
+		'←': 0x7F,
+		'°': 0xDF,
+		'¥': 0x5C,
+		// Katakana (partial set).
+		'ア': 0xB1,
+		'イ': 0xB2,
+		'ウ': 0xB3,
+		'エ': 0xB4,
+		'オ': 0xB5,
+	},
+	ROMA02: {
+		'→': 0x7E,
+		'←': 0x7F,
+		'°': 0xDF,
+		'¥': 0x5C,
+		'Ω': 0xF4,
+		'ß': 0xE2,
+		// Cyrillic (partial set). Letters that look like Latin ones map
+		// onto the Latin code points.
+		'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H',
+		'О': 'O', 'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X',
+		'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x',
+		'Б': 0xA0,
+		'Г': 0xA1,
+		'Д': 0xE0,
+		'П': 0xA3,
+	},
+}
@@ -0,0 +1,121 @@
+package lcd
+
+import (
+	"time"
+
+	"periph.io/x/conn/v3/gpio"
+)
+
+// pulseEnable latches whatever is currently on the data/RS lines by raising
+// EN, holding it, and dropping it again, per the HD44780 write timing.
+func pulseEnable(en gpio.PinOut) error {
+	if err := en.Out(gpio.High); err != nil {
+		return err
+	}
+	time.Sleep(1 * time.Microsecond)
+	if err := en.Out(gpio.Low); err != nil {
+		return err
+	}
+	time.Sleep(50 * time.Microsecond)
+	return nil
+}
+
+// GPIO4BitBus drives an HD44780-compatible controller directly from GPIO
+// pins in 4-bit mode (RS, EN and D4-D7), without a PCF8574 I2C expander. RW
+// is assumed tied to ground (write-only).
+type GPIO4BitBus struct {
+	rs        gpio.PinOut
+	en        gpio.PinOut
+	data      [4]gpio.PinOut // D4-D7
+	backlight gpio.PinOut    // optional, nil if the wiring has no backlight control
+}
+
+// NewGPIO4BitBus returns a Bus driving the controller's D4-D7 pins directly.
+// backlight may be nil if the wiring has no backlight transistor under
+// software control.
+func NewGPIO4BitBus(rs, en, d4, d5, d6, d7, backlight gpio.PinOut) *GPIO4BitBus {
+	return &GPIO4BitBus{
+		rs:        rs,
+		en:        en,
+		data:      [4]gpio.PinOut{d4, d5, d6, d7},
+		backlight: backlight,
+	}
+}
+
+// WriteNibble implements Bus.
+func (b *GPIO4BitBus) WriteNibble(data, rs byte) error {
+	if err := b.rs.Out(gpio.Level(rs != 0)); err != nil {
+		return err
+	}
+	for i, pin := range b.data {
+		if err := pin.Out(gpio.Level((data>>i)&0x1 != 0)); err != nil {
+			return err
+		}
+	}
+	return pulseEnable(b.en)
+}
+
+// SetBacklight implements Bus.
+func (b *GPIO4BitBus) SetBacklight(on bool) error {
+	if b.backlight == nil {
+		return nil
+	}
+	return b.backlight.Out(gpio.Level(on))
+}
+
+// Close implements Bus. GPIO pins are left as-is; periph.io owns their
+// lifecycle, not this package.
+func (b *GPIO4BitBus) Close() error {
+	return nil
+}
+
+// GPIO8BitBus drives an HD44780-compatible controller directly from GPIO
+// pins wired to the full 8-bit data bus (D0-D7). LCD only ever drives the
+// controller in 4-bit transfer mode (lcd.init sends the classic 4-bit
+// bootstrap and the function-set command always has lcdDL4Bit set), calling
+// WriteNibble twice per byte, high nibble then low. GPIO8BitBus follows the
+// same protocol: each WriteNibble call places its nibble on D4-D7 (D0-D3
+// are left unused) and pulses EN once, exactly like GPIO4BitBus. This lets
+// boards with all 8 data lines wired run the same 4-bit protocol without
+// rewiring, rather than desyncing the controller's nibble state machine by
+// pulsing once per byte.
+type GPIO8BitBus struct {
+	rs        gpio.PinOut
+	en        gpio.PinOut
+	data      [8]gpio.PinOut // D0-D7; only D4-D7 are driven
+	backlight gpio.PinOut    // optional, nil if the wiring has no backlight control
+}
+
+// NewGPIO8BitBus returns a Bus driving the controller's D0-D7 pins directly.
+// backlight may be nil if the wiring has no backlight transistor under
+// software control.
+func NewGPIO8BitBus(rs, en gpio.PinOut, d [8]gpio.PinOut, backlight gpio.PinOut) *GPIO8BitBus {
+	return &GPIO8BitBus{rs: rs, en: en, data: d, backlight: backlight}
+}
+
+// WriteNibble implements Bus.
+func (b *GPIO8BitBus) WriteNibble(data, rs byte) error {
+	if err := b.rs.Out(gpio.Level(rs != 0)); err != nil {
+		return err
+	}
+	for i := 0; i < 4; i++ {
+		if err := b.data[4+i].Out(gpio.Level((data>>i)&0x1 != 0)); err != nil {
+			return err
+		}
+	}
+	return pulseEnable(b.en)
+}
+
+// SetBacklight implements Bus.
+func (b *GPIO8BitBus) SetBacklight(on bool) error {
+	if b.backlight == nil {
+		return nil
+	}
+	return b.backlight.Out(gpio.Level(on))
+}
+
+// Close implements Bus. GPIO pins are left as-is; periph.io owns their
+// lifecycle, not this package.
+func (b *GPIO8BitBus) Close() error {
+	return nil
+}
@@ -20,8 +20,6 @@ package lcd
 import (
 	"fmt"
 	"time"
-
-	"golang.org/x/exp/io/i2c"
 )
 
 const (
@@ -35,11 +33,6 @@ const (
 const (
 	lcdRegisterCommand = 0x0
 	lcdRegisterData    = 0x1
-	// lcdReadMode        = 0x2 // useless in most cases, due to PCF8574-like circuits
-	lcdWriteMode    = 0x0
-	lcdEnableBit    = 0x4
-	lcdBacklightOn  = 0x08
-	lcdBacklightOff = 0x00
 
 	lcdClear = 0x01
 	lcdHome  = 0x02
@@ -68,20 +61,40 @@ const (
 
 	lcdCGRAMAddrBase = 0x40 // 0b01000000
 	lcdDDRAMAddrBase = 0x80 // 0b10000000
+
+	lcdCursorShift  = 0x10 // 0b010000 cursor or display shift instruction
+	lcdShiftDisplay = 0x08 // 0b001000 S/C bit: 1 = shift display, 0 = move cursor
+	lcdShiftRight   = 0x04 // 0b000100 R/L bit: 1 = right, 0 = left
+)
+
+// TextDirection selects which way the cursor advances after each character
+// is written, per the HD44780 entry mode instruction.
+type TextDirection int
+
+const (
+	LeftToRight TextDirection = iota
+	RightToLeft
 )
 
-// LCD represents an LCD 1602 display connected via I2C.
+// LCD represents an LCD 1602 display, driven over a Bus (I2C expander,
+// direct GPIO, or any other transport implementing the interface).
 type LCD struct {
-	i2c       *i2c.Device
-	backlight byte
-	cols      int
-	rows      int
-	font      byte
+	bus         Bus
+	backlightOn bool
+	cols        int
+	rows        int
+	font        byte
+	rom         CharsetROM
+	fallback    byte
+	glyphs      map[rune]byte
+	worker      *worker
 
 	displayState byte
+	entryMode    byte
 }
 
-// New creates and initializes a new LCD1602 display instance connected via I2C.
+// New creates and initializes a new LCD1602 display instance connected via a
+// PCF8574 I2C expander.
 
 // Parameters:
 //   - bus: The I2C bus device path (e.g., "/dev/i2c-1" or use DefaultDevice constant).
@@ -89,6 +102,7 @@ type LCD struct {
 //   - cols: Number of columns (characters per line). Valid values: 16, 20.
 //   - rows: Number of display rows. Valid values: 1, 2, 4.
 //   - font: Font size specification. Must be one of: Font5x8 (standard 5x8 pixel font) or Font5x10 (5x10 pixel font).
+//   - rom: The character-set ROM installed in the controller (ROMA00 or ROMA02); check your datasheet.
 //   - isBacklightOn: Whether to enable the backlight LED immediately upon initialization.
 //
 // Valid combinations:
@@ -101,30 +115,53 @@ type LCD struct {
 //
 // Note: The 5x10 font is typically only available for single-line displays (rows=1).
 // For multi-line displays, Font5x8 should be used.
-func New(bus string, address int, cols int, rows int, font byte, isBacklightOn bool) (*LCD, error) {
-	if err := validateInputs(cols, rows, font); err != nil {
-		return nil, fmt.Errorf("invalid inputs: %w", err)
+//
+// To drive the controller over a different transport (direct GPIO, etc.),
+// use NewWithBus instead.
+func New(bus string, address int, cols int, rows int, font byte, rom CharsetROM, isBacklightOn bool) (*LCD, error) {
+	pcfBus, err := NewPCF8574Bus(bus, address)
+	if err != nil {
+		return nil, err
 	}
 
-	i2cDevice, err := i2c.Open(&i2c.Devfs{Dev: bus}, address)
+	lcd, err := NewWithBus(pcfBus, cols, rows, font, rom, isBacklightOn)
 	if err != nil {
+		_ = pcfBus.Close()
 		return nil, err
 	}
+	return lcd, nil
+}
+
+// NewWithBus creates and initializes a new LCD1602 display instance driven
+// by an arbitrary Bus implementation. See New for the meaning of cols, rows,
+// font, rom and isBacklightOn.
+func NewWithBus(bus Bus, cols int, rows int, font byte, rom CharsetROM, isBacklightOn bool) (*LCD, error) {
+	if err := validateInputs(cols, rows, font); err != nil {
+		return nil, fmt.Errorf("invalid inputs: %w", err)
+	}
+
 	lcd := &LCD{
-		i2c:  i2cDevice,
-		cols: cols,
-		rows: rows,
-		font: font,
+		bus:      bus,
+		cols:     cols,
+		rows:     rows,
+		font:     font,
+		rom:      rom,
+		fallback: '?',
 		// initial display state
 		displayState: lcdDisplayOn | lcdDisplayCursorOff | lcdDisplayBlinkOff,
+		// initial entry mode: cursor moves right, no display shift
+		entryMode: lcdEntryModeIDIncr | lcdEntryModeShiftDisable,
 	}
-	if err := lcd.init(); err != nil {
+
+	if err := bus.SetBacklight(isBacklightOn); err != nil {
 		return nil, err
 	}
+	lcd.backlightOn = isBacklightOn
 
-	if isBacklightOn {
-		lcd.backlight = lcdBacklightOn
+	if err := lcd.init(); err != nil {
+		return nil, err
 	}
+
 	return lcd, nil
 }
 
@@ -150,9 +187,9 @@ func validateInputs(cols, rows int, font byte) error {
 	return nil
 }
 
-// Close device.
+// Close releases the underlying bus.
 func (lcd *LCD) Close() error {
-	return lcd.i2c.Close()
+	return lcd.bus.Close()
 }
 
 // Init initializes the LCD display in 4-bit mode.
@@ -198,7 +235,7 @@ func (lcd *LCD) init() error {
 	}
 
 	// Entry mode set: cursor moves right, no display shift.
-	if err := lcd.sendCommand(lcdEntryModeSet | lcdEntryModeIDIncr | lcdEntryModeShiftDisable); err != nil {
+	if err := lcd.sendCommand(lcdEntryModeSet | lcd.entryMode); err != nil {
 		return err
 	}
 
@@ -252,8 +289,8 @@ func (lcd *LCD) UploadCustomChar(location byte, char [8]byte) error {
 //   - columns: [0-15]
 //   - rows: [0-1]
 func (lcd *LCD) SetCursor(row, col int) error {
-	if (col < 0) || (col >= lcd.cols) {
-		return fmt.Errorf("invalid col: %d", col)
+	if err := lcd.validateCell(row, col); err != nil {
+		return err
 	}
 
 	var addr byte
@@ -266,14 +303,25 @@ func (lcd *LCD) SetCursor(row, col int) error {
 		addr = lcdDDRAMAddrBase + 0x14
 	case 3:
 		addr = lcdDDRAMAddrBase + 0x54
-	default:
-		return fmt.Errorf("invalid row: %d", row)
 	}
 
 	addr += byte(col)
 	return lcd.sendCommand(addr)
 }
 
+// validateCell reports whether (row, col) is addressable on this display,
+// i.e. 0 <= col < lcd.cols and row is one of the (up to 4) rows SetCursor
+// knows an address for.
+func (lcd *LCD) validateCell(row, col int) error {
+	if (col < 0) || (col >= lcd.cols) {
+		return fmt.Errorf("invalid col: %d", col)
+	}
+	if row < 0 || row > 3 || row >= lcd.rows {
+		return fmt.Errorf("invalid row: %d", row)
+	}
+	return nil
+}
+
 // Print prints text to the display, starting from specified row and column.
 // Check SetCursor documentation for valid row, column values.
 func (lcd *LCD) Print(text string, row, col int) error {
@@ -294,15 +342,78 @@ func (lcd *LCD) PrintRAW(raw byte, row, col int) error {
 }
 
 // Write prints text to the display, starting from current cursor position.
+// text is interpreted as UTF-8; each rune is translated to the active
+// CharsetROM's code point (see RegisterGlyphs and SetFallback for runes the
+// ROM doesn't natively support).
 func (lcd *LCD) Write(text string) error {
-	for _, char := range text {
-		if err := lcd.sendData(byte(char)); err != nil {
+	for _, r := range text {
+		if err := lcd.sendData(lcd.translate(r)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// SetFallback sets the raw controller code point written in place of runes
+// that have no entry in the active CharsetROM's table and no registered
+// CGRAM glyph. The default is '?'.
+func (lcd *LCD) SetFallback(raw byte) {
+	lcd.fallback = raw
+}
+
+// translate maps r to the active CharsetROM's controller code point. ASCII
+// 0x20-0x7D passes through unchanged; runes uploaded via
+// RegisterGlyphs take priority over the ROM table.
+func (lcd *LCD) translate(r rune) byte {
+	// Registered glyphs take priority: RegisterGlyphs lets a caller override
+	// even an ASCII rune's native rendering (e.g. a custom '@').
+	if loc, ok := lcd.glyphs[r]; ok {
+		return loc
+	}
+	if r >= 0x20 && r <= 0x7D {
+		return byte(r)
+	}
+	if b, ok := charsetTables[lcd.rom][r]; ok {
+		return b
+	}
+	return lcd.fallback
+}
+
+// builtinGlyphs holds the 5x8 bitmaps RegisterGlyphs can upload. '@' is also
+// in both character-set ROMs, so registering it replaces the ROM's rendering.
+var builtinGlyphs = map[rune][8]byte{
+	'€': {0b00110, 0b01111, 0b01000, 0b11110, 0b01000, 0b01111, 0b00110, 0b00000},
+	'@': {0b01110, 0b10001, 0b10111, 0b10101, 0b10111, 0b10000, 0b01111, 0b00000},
+}
+
+// RegisterGlyphs uploads bitmaps for up to 8 runes into CGRAM locations
+// 0x00-0x07 from a small bundled glyph library, and records the mapping so
+// Write and Print translate those runes to the uploaded locations from then
+// on. It returns an error if more than 8 runes are given, or if a rune has
+// no bundled glyph.
+func (lcd *LCD) RegisterGlyphs(runes []rune) error {
+	if len(runes) > 8 {
+		return fmt.Errorf("too many glyphs: %d, CGRAM only holds 8", len(runes))
+	}
+
+	if lcd.glyphs == nil {
+		lcd.glyphs = make(map[rune]byte, len(runes))
+	}
+	for i, r := range runes {
+		glyph, ok := builtinGlyphs[r]
+		if !ok {
+			return fmt.Errorf("no bundled glyph for rune %q", r)
+		}
+
+		loc := byte(i)
+		if err := lcd.UploadCustomChar(loc, glyph); err != nil {
+			return err
+		}
+		lcd.glyphs[r] = loc
+	}
+	return nil
+}
+
 // WriteRAW prints one character by raw address in current cursor position.
 // See table 4 on pages 17-18, depending on your module.
 func (lcd *LCD) WriteRAW(raw byte) error {
@@ -314,22 +425,22 @@ func (lcd *LCD) WriteRAW(raw byte) error {
 
 // EnableBacklight enables LED backlighting.
 func (lcd *LCD) EnableBacklight() error {
-	lcd.backlight = lcdBacklightOn
-	return lcd.busWrite(lcd.backlight)
+	lcd.backlightOn = true
+	return lcd.bus.SetBacklight(true)
 }
 
 // DisableBacklight disables LED backlighting.
 func (lcd *LCD) DisableBacklight() error {
-	lcd.backlight = lcdBacklightOff
-	return lcd.busWrite(lcd.backlight)
+	lcd.backlightOn = false
+	return lcd.bus.SetBacklight(false)
 }
 
 // ToggleBacklight flips LED backlighting. If it was on: turns off; if it was off: turns on.
 func (lcd *LCD) ToggleBacklight() error {
-	if lcd.backlight == lcdBacklightOff {
-		return lcd.EnableBacklight()
+	if lcd.backlightOn {
+		return lcd.DisableBacklight()
 	}
-	return lcd.DisableBacklight()
+	return lcd.EnableBacklight()
 }
 
 // DisplayOn turns on the LCD display.
@@ -403,6 +514,58 @@ func (lcd *LCD) ToggleBlink() error {
 	return lcd.BlinkOn()
 }
 
+// ShiftDisplayLeft shifts the entire display (not the cursor) one position
+// to the left; the cursor moves with it.
+func (lcd *LCD) ShiftDisplayLeft() error {
+	return lcd.sendCommand(lcdCursorShift | lcdShiftDisplay)
+}
+
+// ShiftDisplayRight shifts the entire display one position to the right.
+func (lcd *LCD) ShiftDisplayRight() error {
+	return lcd.sendCommand(lcdCursorShift | lcdShiftDisplay | lcdShiftRight)
+}
+
+// MoveCursorLeft moves the cursor one position to the left without
+// shifting the display.
+func (lcd *LCD) MoveCursorLeft() error {
+	return lcd.sendCommand(lcdCursorShift)
+}
+
+// MoveCursorRight moves the cursor one position to the right without
+// shifting the display.
+func (lcd *LCD) MoveCursorRight() error {
+	return lcd.sendCommand(lcdCursorShift | lcdShiftRight)
+}
+
+// SetTextDirection selects whether the cursor moves right (LeftToRight) or
+// left (RightToLeft) after each character is written.
+func (lcd *LCD) SetTextDirection(dir TextDirection) error {
+	switch dir {
+	case LeftToRight:
+		lcd.entryMode |= lcdEntryModeIDIncr
+	case RightToLeft:
+		lcd.entryMode &= ^(byte(lcdEntryModeIDIncr))
+	default:
+		return fmt.Errorf("invalid text direction: %d", dir)
+	}
+	return lcd.sendCommand(lcdEntryModeSet | lcd.entryMode)
+}
+
+// AutoScrollOn makes the entire display shift as each character is
+// written, keeping the cursor stationary while existing text scrolls out of
+// the way.
+func (lcd *LCD) AutoScrollOn() error {
+	lcd.entryMode |= lcdEntryModeShiftEnable
+	return lcd.sendCommand(lcdEntryModeSet | lcd.entryMode)
+}
+
+// AutoScrollOff disables auto-scroll, so the cursor moves and written text
+// stays put. This is the default.
+func (lcd *LCD) AutoScrollOff() error {
+	lcd.entryMode &= ^(byte(lcdEntryModeShiftEnable))
+	return lcd.sendCommand(lcdEntryModeSet | lcd.entryMode)
+}
+
 // sendCommand sends a command to the LCD.
 func (lcd *LCD) sendCommand(command byte) error {
 	return lcd.send(command, lcdRegisterCommand)
@@ -413,49 +576,14 @@ func (lcd *LCD) sendData(data byte) error {
 	return lcd.send(data, lcdRegisterData)
 }
 
-// send sends a byte to the LCD (4-bit mode).
+// send sends a byte to the LCD, high nibble first then low nibble, as
+// required by the controller's 4-bit transfer mode.
 func (lcd *LCD) send(value byte, rs byte) error {
-	// Prepare data for I2C communication
-	// High nibble
 	high := value >> 4
-	if err := lcd.writeByte(high, rs); err != nil {
+	if err := lcd.bus.WriteNibble(high, rs); err != nil {
 		return err
 	}
 
-	// Low nibble
 	low := value & 0x0F
-	return lcd.writeByte(low, rs)
-}
-
-// writeByte writes a byte to the I2C device.
-func (lcd *LCD) writeByte(value byte, rs byte) error {
-	// Prepare I2C data.
-	// Start filling data with technical bits (P0-P3).
-	data := rs | lcdWriteMode | lcdEnableBit | lcd.backlight
-
-	// Set data bits (P4-P7).
-	data |= (value << 4)
-
-	// Send to I2C device.
-	if err := lcd.busWrite(data); err != nil {
-		return err
-	}
-
-	// Toggle enable bit to latch data.
-	data &= ^(byte(lcdEnableBit))
-	if err := lcd.busWrite(data); err != nil {
-		return err
-	}
-
-	// Small delay for timing.
-	time.Sleep(50 * time.Microsecond)
-	return nil
-}
-
-// busWrite writes a single byte to the I2C bus.
-func (lcd *LCD) busWrite(data byte) error {
-	if err := lcd.i2c.Write([]byte{data}); err != nil {
-		return fmt.Errorf("I2C write error: %v", err)
-	}
-	return nil
+	return lcd.bus.WriteNibble(low, rs)
 }
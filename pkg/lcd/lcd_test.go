@@ -0,0 +1,123 @@
+package lcd
+
+import "testing"
+
+// fakeBus is a Bus that reconstructs the bytes LCD sends (pairing the high
+// and low nibbles of each WriteNibble call, as the real protocol requires)
+// instead of driving any hardware, so tests can assert on what LCD tried to
+// send.
+type fakeBus struct {
+	backlightOn bool
+
+	havePending bool
+	pendingHigh byte
+
+	commands []byte // full bytes sent with rs == lcdRegisterCommand
+	data     []byte // full bytes sent with rs == lcdRegisterData
+}
+
+func (b *fakeBus) WriteNibble(nibble, rs byte) error {
+	if !b.havePending {
+		b.pendingHigh = nibble
+		b.havePending = true
+		return nil
+	}
+	value := b.pendingHigh<<4 | nibble
+	b.havePending = false
+	if rs == lcdRegisterCommand {
+		b.commands = append(b.commands, value)
+	} else {
+		b.data = append(b.data, value)
+	}
+	return nil
+}
+
+func (b *fakeBus) SetBacklight(on bool) error {
+	b.backlightOn = on
+	return nil
+}
+
+func (b *fakeBus) Close() error {
+	return nil
+}
+
+// reset discards everything recorded so far, e.g. the commands init() sent.
+func (b *fakeBus) reset() {
+	b.commands = nil
+	b.data = nil
+}
+
+// newTestLCD returns an LCD driven by a fakeBus, with the bus's recorded
+// commands/data cleared after initialization so tests only see what their
+// own calls sent.
+func newTestLCD(t *testing.T, cols, rows int) (*LCD, *fakeBus) {
+	t.Helper()
+	fb := &fakeBus{}
+	lcd, err := NewWithBus(fb, cols, rows, Font5x8, ROMA00, false)
+	if err != nil {
+		t.Fatalf("NewWithBus: %v", err)
+	}
+	fb.reset()
+	return lcd, fb
+}
+
+func TestTranslateASCIIPassthrough(t *testing.T) {
+	lcd, _ := newTestLCD(t, 16, 2)
+
+	for _, r := range []rune{'A', 'z', '0', ' ', '}'} {
+		if got := lcd.translate(r); got != byte(r) {
+			t.Errorf("translate(%q) = %#x, want %#x", r, got, byte(r))
+		}
+	}
+}
+
+func TestTranslateCharsetTable(t *testing.T) {
+	tests := []struct {
+		rom  CharsetROM
+		r    rune
+		want byte
+	}{
+		{ROMA00, '→', 0x7E},
+		{ROMA00, 'ア', 0xB1},
+		{ROMA02, 'Ω', 0xF4},
+		{ROMA02, 'Б', 0xA0},
+	}
+	for _, tt := range tests {
+		fb := &fakeBus{}
+		lcd, err := NewWithBus(fb, 16, 2, Font5x8, tt.rom, false)
+		if err != nil {
+			t.Fatalf("NewWithBus: %v", err)
+		}
+		if got := lcd.translate(tt.r); got != tt.want {
+			t.Errorf("translate(%q) with rom %v = %#x, want %#x", tt.r, tt.rom, got, tt.want)
+		}
+	}
+}
+
+func TestTranslateUnknownRuneFallsBack(t *testing.T) {
+	lcd, _ := newTestLCD(t, 16, 2)
+
+	if got := lcd.translate('漢'); got != lcd.fallback {
+		t.Errorf("translate('漢') = %#x, want fallback %#x", got, lcd.fallback)
+	}
+
+	lcd.SetFallback('*')
+	if got := lcd.translate('漢'); got != '*' {
+		t.Errorf("translate('漢') after SetFallback('*') = %#x, want %#x", got, byte('*'))
+	}
+}
+
+// TestTranslateRegisteredGlyphBeatsASCII is a regression test: '@' falls
+// inside the ASCII passthrough range (0x20-0x7D), so translate must check
+// lcd.glyphs first or a registered '@' glyph is silently never used.
+func TestTranslateRegisteredGlyphBeatsASCII(t *testing.T) {
+	lcd, _ := newTestLCD(t, 16, 2)
+
+	if err := lcd.RegisterGlyphs([]rune{'@'}); err != nil {
+		t.Fatalf("RegisterGlyphs: %v", err)
+	}
+
+	if got, want := lcd.translate('@'), byte(0); got != want {
+		t.Errorf("translate('@') = %#x, want CGRAM location %#x", got, want)
+	}
+}
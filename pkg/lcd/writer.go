@@ -0,0 +1,236 @@
+package lcd
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// parser states for lcdWriter's escape-sequence state machine.
+const (
+	writerStateNormal  = iota
+	writerStateEscape  // saw ESC
+	writerStateBracket // saw ESC [
+	writerStateCommand // collecting "L<digits>[;<digits>...]" until a letter terminates it
+	writerStateGlyph   // collecting "G" + 17 hex digits: 1 location, 8x2 glyph row bytes
+)
+
+// glyphHexLen is the number of hex digits a "ESC [ LG..." command collects:
+// one for the CGRAM location (0-7) plus two per glyph row (8 rows).
+const glyphHexLen = 1 + 2*8
+
+// lcdWriter adapts *LCD to io.Writer, interpreting the byte stream as a
+// practical subset of the Linux charlcd escape language (see
+// drivers/auxdisplay/charlcd.c): '\n', '\r', '\b' and '\f' behave like on a
+// text console, and "ESC [ L..." sequences drive backlight, cursor, blink,
+// display power, display shift and cursor positioning. "ESC [ LG<loc><rows>" defines a
+// CGRAM glyph from hex: one hex digit for the location (0-7) followed by 8
+// two-digit hex rows. State is kept across Write calls so a caller can feed
+// it one byte at a time, e.g. via fmt.Fprintf or bufio, without ever
+// splitting an escape sequence.
+type lcdWriter struct {
+	lcd   *LCD
+	row   int
+	col   int
+	state int
+	cmd   strings.Builder
+}
+
+// Writer returns an io.Writer that streams the charlcd escape language (see
+// lcdWriter) to lcd, starting at the current cursor position.
+func (lcd *LCD) Writer() io.Writer {
+	return &lcdWriter{lcd: lcd}
+}
+
+// Write implements io.Writer.
+func (w *lcdWriter) Write(p []byte) (int, error) {
+	for i, b := range p {
+		if err := w.feed(b); err != nil {
+			return i, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *lcdWriter) feed(b byte) error {
+	switch w.state {
+	case writerStateEscape:
+		if b == '[' {
+			w.state = writerStateBracket
+			return nil
+		}
+		w.state = writerStateNormal
+		return w.feed(b)
+	case writerStateBracket:
+		if b != 'L' {
+			w.state = writerStateNormal
+			return nil
+		}
+		w.state = writerStateCommand
+		w.cmd.Reset()
+		return nil
+	case writerStateCommand:
+		// "G" never appears as a terminator letter (its hex payload follows
+		// it, and the payload's A-F digits would end the argument list), so
+		// it's recognized as soon as it opens the command, before any digits
+		// are collected.
+		if w.cmd.Len() == 0 && b == 'G' {
+			w.state = writerStateGlyph
+			w.cmd.Reset()
+			return nil
+		}
+		if (b >= '0' && b <= '9') || b == ';' {
+			w.cmd.WriteByte(b)
+			return nil
+		}
+		// Any other byte terminates the command; b identifies which one.
+		err := w.runCommand(b, w.cmd.String())
+		w.state = writerStateNormal
+		return err
+	case writerStateGlyph:
+		if !isHexDigit(b) {
+			// Malformed sequence: abort it and reprocess b as normal input.
+			w.state = writerStateNormal
+			return w.feed(b)
+		}
+		w.cmd.WriteByte(b)
+		if w.cmd.Len() < glyphHexLen {
+			return nil
+		}
+		err := w.runGlyphCommand(w.cmd.String())
+		w.state = writerStateNormal
+		return err
+	}
+
+	switch b {
+	case 0x1b:
+		w.state = writerStateEscape
+	case '\n':
+		w.row++
+		w.col = 0
+		return w.moveTo(w.row, w.col)
+	case '\r':
+		w.col = 0
+		return w.moveTo(w.row, w.col)
+	case '\b':
+		if w.col > 0 {
+			w.col--
+		}
+		return w.moveTo(w.row, w.col)
+	case '\f':
+		w.row, w.col = 0, 0
+		return w.lcd.Clear()
+	default:
+		if err := w.lcd.WriteRAW(w.lcd.translate(rune(b))); err != nil {
+			return err
+		}
+		w.col++
+		if w.col >= w.lcd.cols {
+			return w.moveTo(w.row+1, 0)
+		}
+	}
+	return nil
+}
+
+// moveTo clamps row to the display's row range and moves there.
+func (w *lcdWriter) moveTo(row, col int) error {
+	if row >= w.lcd.rows {
+		row = w.lcd.rows - 1
+	}
+	if row < 0 {
+		row = 0
+	}
+	w.row, w.col = row, col
+	return w.lcd.SetCursor(row, col)
+}
+
+// runCommand executes one "ESC [ L<args><letter>" command, where letter is
+// the byte that terminated the numeric argument list and args is the
+// ';'-separated digits collected before it.
+func (w *lcdWriter) runCommand(letter byte, args string) error {
+	nums := splitArgs(args)
+	flag := len(nums) == 0 || nums[0] != 0
+
+	switch letter {
+	case 'B': // backlight
+		if flag {
+			return w.lcd.EnableBacklight()
+		}
+		return w.lcd.DisableBacklight()
+	case 'C': // cursor
+		if flag {
+			return w.lcd.CursorOn()
+		}
+		return w.lcd.CursorOff()
+	case 'b': // blink
+		if flag {
+			return w.lcd.BlinkOn()
+		}
+		return w.lcd.BlinkOff()
+	case 'D': // display power
+		if flag {
+			return w.lcd.DisplayOn()
+		}
+		return w.lcd.DisplayOff()
+	case 'l': // shift display left
+		return w.lcd.ShiftDisplayLeft()
+	case 'r': // shift display right
+		return w.lcd.ShiftDisplayRight()
+	case 'x': // move to (x, y): args are col, row
+		col, row := 0, 0
+		if len(nums) > 0 {
+			col = nums[0]
+		}
+		if len(nums) > 1 {
+			row = nums[1]
+		}
+		return w.moveTo(row, col)
+	default:
+		return nil
+	}
+}
+
+// runGlyphCommand executes a completed "ESC [ LG<loc><rows>" command: hex is
+// exactly glyphHexLen hex digits, one for the CGRAM location (0-7) followed
+// by 8 two-digit glyph rows.
+func (w *lcdWriter) runGlyphCommand(hex string) error {
+	loc, err := strconv.ParseUint(hex[:1], 16, 8)
+	if err != nil {
+		return fmt.Errorf("charlcd: invalid glyph location %q: %w", hex[:1], err)
+	}
+
+	var glyph [8]byte
+	for i := range glyph {
+		row := hex[1+i*2 : 3+i*2]
+		n, err := strconv.ParseUint(row, 16, 8)
+		if err != nil {
+			return fmt.Errorf("charlcd: invalid glyph row %q: %w", row, err)
+		}
+		glyph[i] = byte(n)
+	}
+	return w.lcd.UploadCustomChar(byte(loc), glyph)
+}
+
+// isHexDigit reports whether b is an ASCII hex digit (0-9, a-f, A-F).
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
+
+// splitArgs parses a ';'-separated list of decimal integers, ignoring
+// entries that don't parse (e.g. an empty argument).
+func splitArgs(s string) []int {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ";")
+	nums := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			continue
+		}
+		nums = append(nums, n)
+	}
+	return nums
+}
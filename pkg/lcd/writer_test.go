@@ -0,0 +1,143 @@
+package lcd
+
+import "testing"
+
+func TestWriterPlainTextAndWrap(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	w := lcd.Writer()
+
+	text := "0123456789ABCDEFG" // 17 bytes, one past the 16-column width
+	if _, err := w.Write([]byte(text)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if string(fb.data) != text {
+		t.Errorf("data written = %v, want %v", fb.data, []byte(text))
+	}
+
+	// The display is 16 columns wide, so the 17th byte ('G') should have
+	// wrapped onto row 1, col 0, leaving the cursor at row 1, col 1.
+	lw := w.(*lcdWriter)
+	if lw.row != 1 || lw.col != 1 {
+		t.Errorf("cursor after wrap = (%d, %d), want (1, 1)", lw.row, lw.col)
+	}
+}
+
+func TestWriterControlChars(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	w := lcd.Writer().(*lcdWriter)
+
+	if _, err := w.Write([]byte("AB\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if w.row != 1 || w.col != 0 {
+		t.Errorf("after \\n: cursor = (%d, %d), want (1, 0)", w.row, w.col)
+	}
+
+	if _, err := w.Write([]byte("C\r")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if w.col != 0 {
+		t.Errorf("after \\r: col = %d, want 0", w.col)
+	}
+
+	if _, err := w.Write([]byte("DE\b")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if w.col != 1 {
+		t.Errorf("after \\b: col = %d, want 1", w.col)
+	}
+
+	fb.reset()
+	if _, err := w.Write([]byte("\f")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if w.row != 0 || w.col != 0 {
+		t.Errorf("after \\f: cursor = (%d, %d), want (0, 0)", w.row, w.col)
+	}
+	if len(fb.commands) != 1 || fb.commands[0] != lcdClear {
+		t.Errorf("after \\f: commands = %v, want [%#x] (Clear)", fb.commands, lcdClear)
+	}
+}
+
+func TestWriterEscapeCommands(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	w := lcd.Writer()
+
+	// ESC [ L 1 B -> backlight on.
+	if _, err := w.Write([]byte("\x1b[L1B")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !fb.backlightOn {
+		t.Error("backlight not enabled by ESC[L1B")
+	}
+
+	// ESC [ L 0 B -> backlight off.
+	if _, err := w.Write([]byte("\x1b[L0B")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if fb.backlightOn {
+		t.Error("backlight not disabled by ESC[L0B")
+	}
+
+	// ESC [ L 3;1 x -> move to col 3, row 1.
+	fb.reset()
+	if _, err := w.Write([]byte("\x1b[L3;1x")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	lw := w.(*lcdWriter)
+	if lw.row != 1 || lw.col != 3 {
+		t.Errorf("cursor after ESC[L3;1x = (%d, %d), want (1, 3)", lw.row, lw.col)
+	}
+	wantAddr := byte(lcdDDRAMAddrBase + 0x40 + 3)
+	if len(fb.commands) != 1 || fb.commands[0] != wantAddr {
+		t.Errorf("commands after ESC[L3;1x = %v, want [%#x]", fb.commands, wantAddr)
+	}
+}
+
+// TestWriterGlyphCommand is a regression test: runGlyphCommand's hex parsing
+// previously truncated at the first A-F digit instead of parsing it, so
+// glyph rows using the upper hex digits never uploaded correctly.
+func TestWriterGlyphCommand(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	w := lcd.Writer()
+
+	// Location 2, then 8 rows, deliberately using hex digits A-F.
+	seq := "\x1b[LG2AFAFAFAFAFAFAFAF"
+	if _, err := w.Write([]byte(seq)); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	wantCmd := byte(lcdCGRAMAddrBase | (2 << 3))
+	if len(fb.commands) != 1 || fb.commands[0] != wantCmd {
+		t.Fatalf("commands = %v, want [%#x] (CGRAM address set)", fb.commands, wantCmd)
+	}
+
+	wantData := []byte{0xAF, 0xAF, 0xAF, 0xAF, 0xAF, 0xAF, 0xAF, 0xAF}
+	if len(fb.data) != len(wantData) {
+		t.Fatalf("data = %v, want %v", fb.data, wantData)
+	}
+	for i, b := range wantData {
+		if fb.data[i] != b {
+			t.Errorf("data[%d] = %#x, want %#x", i, fb.data[i], b)
+		}
+	}
+}
+
+// TestWriterMalformedGlyphCommand checks that a non-hex byte aborts the
+// glyph sequence and is reprocessed as ordinary text, rather than being
+// silently swallowed or panicking.
+func TestWriterMalformedGlyphCommand(t *testing.T) {
+	lcd, fb := newTestLCD(t, 16, 2)
+	w := lcd.Writer()
+
+	// "G1" starts a glyph command, then a non-hex byte ('z') aborts it and
+	// should be printed as a normal character instead.
+	if _, err := w.Write([]byte("\x1b[LG1z")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if len(fb.data) != 1 || fb.data[0] != 'z' {
+		t.Errorf("data = %v, want ['z'] written as plain text", fb.data)
+	}
+}